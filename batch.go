@@ -0,0 +1,114 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+)
+
+// Batch stores N vectors of the same dimension D as one contiguous,
+// row-major []float64 of length N*D, so that bulk operations over many
+// vectors (e.g. k-NN over thousands of embeddings) can be implemented
+// without per-vector allocation and stay friendly to a future SIMD or
+// gonum/blas backend.
+type Batch struct {
+	Data []float64
+	N    int
+	D    int
+}
+
+// NewBatch allocates an empty Batch of n vectors of dimension d
+func NewBatch(n, d int) Batch {
+	return Batch{Data: make([]float64, n*d), N: n, D: d}
+}
+
+// BatchFrom packs a set of equal-length vectors into a Batch
+func BatchFrom(vecs ...Vector) (Batch, error) {
+	if len(vecs) == 0 {
+		return Batch{}, nil
+	}
+	d := len(vecs[0])
+	b := NewBatch(len(vecs), d)
+	for i, v := range vecs {
+		if len(v) != d {
+			return Batch{}, &DimensionError{fmt.Errorf("BatchFrom: vector %d has length %d, want %d", i, len(v), d)}
+		}
+		copy(b.Data[i*d:(i+1)*d], v)
+	}
+	return b, nil
+}
+
+// At returns the i-th vector in the batch as a view into its storage;
+// mutating it mutates the batch in place.
+func (b Batch) At(i int) Vector {
+	return Vector(b.Data[i*b.D : (i+1)*b.D])
+}
+
+// BatchAdd returns the element-wise sum of b and other as a new Batch.
+// b and other must have the same N and D.
+func (b Batch) BatchAdd(other Batch) Batch {
+	if b.N != other.N || b.D != other.D {
+		panic(fmt.Sprintf("vector: BatchAdd: batch dimensions %dx%d and %dx%d differ", b.N, b.D, other.N, other.D))
+	}
+	out := NewBatch(b.N, b.D)
+	for i, x := range b.Data {
+		out.Data[i] = x + other.Data[i]
+	}
+	return out
+}
+
+// BatchScale returns b with every element scaled by n, as a new Batch
+func (b Batch) BatchScale(n float64) Batch {
+	out := NewBatch(b.N, b.D)
+	for i, x := range b.Data {
+		out.Data[i] = x * n
+	}
+	return out
+}
+
+// BatchDot returns the per-row inner product of a and b: result[i] is
+// InnerProduct(a.At(i), b.At(i)). a and b must have the same N and D.
+func BatchDot(a, b Batch) []float64 {
+	if a.N != b.N || a.D != b.D {
+		panic(fmt.Sprintf("vector: BatchDot: batch dimensions %dx%d and %dx%d differ", a.N, a.D, b.N, b.D))
+	}
+	result := make([]float64, a.N)
+	for i := 0; i < a.N; i++ {
+		result[i] = InnerProduct(a.At(i), b.At(i))
+	}
+	return result
+}
+
+// BatchNorm returns the magnitude of each vector in the batch
+func (b Batch) BatchNorm() []float64 {
+	result := make([]float64, b.N)
+	for i := 0; i < b.N; i++ {
+		result[i] = b.At(i).Mag()
+	}
+	return result
+}
+
+// BatchDistance returns the distance from query to each vector in the
+// batch. It uses ||a-b||^2 = ||a||^2 + ||b||^2 - 2*a.b so that a bulk
+// nearest-neighbour query reduces to inner products per row rather than
+// materializing a difference vector per row. Each row's squared norm is
+// computed afresh on every call; it is not cached in the Batch.
+func (b Batch) BatchDistance(query Vector) []float64 {
+	if b.D != len(query) {
+		panic(fmt.Sprintf("vector: BatchDistance: batch dimension %d does not match query length %d", b.D, len(query)))
+	}
+	queryNormSq := InnerProduct(query, query)
+	result := make([]float64, b.N)
+	for i := 0; i < b.N; i++ {
+		row := b.At(i)
+		rowNormSq := InnerProduct(row, row)
+		dot := InnerProduct(row, query)
+		distSq := rowNormSq + queryNormSq - 2*dot
+		if distSq < 0 {
+			// rounding error on a near-zero distance can push this
+			// infinitesimally negative
+			distSq = 0
+		}
+		result[i] = math.Sqrt(distSq)
+	}
+	return result
+}
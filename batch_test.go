@@ -0,0 +1,112 @@
+package vector_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alexcooperse/vector"
+)
+
+func TestBatchFromAndAt(t *testing.T) {
+	b, err := vector.BatchFrom(
+		vector.Vector{1, 2, 3},
+		vector.Vector{4, 5, 6},
+	)
+	if err != nil {
+		t.Fatalf("BatchFrom(...) returned unexpected error: %v", err)
+	}
+	if b.N != 2 || b.D != 3 {
+		t.Fatalf("BatchFrom(...) == {N: %d, D: %d}, want {N: 2, D: 3}", b.N, b.D)
+	}
+	if !vector.DeeplyEqual(b.At(0), vector.Vector{1, 2, 3}) {
+		t.Errorf("b.At(0) == %v, want %v", b.At(0), vector.Vector{1, 2, 3})
+	}
+	if !vector.DeeplyEqual(b.At(1), vector.Vector{4, 5, 6}) {
+		t.Errorf("b.At(1) == %v, want %v", b.At(1), vector.Vector{4, 5, 6})
+	}
+}
+
+func TestBatchFromRejectsRaggedVectors(t *testing.T) {
+	_, err := vector.BatchFrom(vector.Vector{1, 2}, vector.Vector{1, 2, 3})
+	if err == nil {
+		t.Fatal("BatchFrom with ragged vectors returned no error")
+	}
+}
+
+func TestBatchAdd(t *testing.T) {
+	a, _ := vector.BatchFrom(vector.Vector{1, 2}, vector.Vector{3, 4})
+	b, _ := vector.BatchFrom(vector.Vector{10, 20}, vector.Vector{30, 40})
+
+	got := a.BatchAdd(b)
+	if !vector.DeeplyEqual(got.At(0), vector.Vector{11, 22}) {
+		t.Errorf("a.BatchAdd(b).At(0) == %v, want %v", got.At(0), vector.Vector{11, 22})
+	}
+	if !vector.DeeplyEqual(got.At(1), vector.Vector{33, 44}) {
+		t.Errorf("a.BatchAdd(b).At(1) == %v, want %v", got.At(1), vector.Vector{33, 44})
+	}
+}
+
+func TestBatchScale(t *testing.T) {
+	a, _ := vector.BatchFrom(vector.Vector{1, 2}, vector.Vector{3, 4})
+
+	got := a.BatchScale(2)
+	if !vector.DeeplyEqual(got.At(0), vector.Vector{2, 4}) {
+		t.Errorf("a.BatchScale(2).At(0) == %v, want %v", got.At(0), vector.Vector{2, 4})
+	}
+	if !vector.DeeplyEqual(got.At(1), vector.Vector{6, 8}) {
+		t.Errorf("a.BatchScale(2).At(1) == %v, want %v", got.At(1), vector.Vector{6, 8})
+	}
+}
+
+func TestBatchDot(t *testing.T) {
+	a, _ := vector.BatchFrom(vector.Vector{1, 0}, vector.Vector{0, 1})
+	b, _ := vector.BatchFrom(vector.Vector{2, 3}, vector.Vector{4, 5})
+
+	got := vector.BatchDot(a, b)
+	want := []float64{2, 5}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("BatchDot(a, b) == %v, want %v", got, want)
+		}
+	}
+}
+
+func TestBatchNorm(t *testing.T) {
+	b, _ := vector.BatchFrom(vector.Vector{3, 4}, vector.Vector{0, 0})
+
+	got := b.BatchNorm()
+	want := []float64{5, 0}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("b.BatchNorm() == %v, want %v", got, want)
+		}
+	}
+}
+
+func TestBatchDistance(t *testing.T) {
+	b, _ := vector.BatchFrom(
+		vector.Vector{0, 0},
+		vector.Vector{3, 4},
+		vector.Vector{1, 1},
+	)
+	query := vector.Vector{0, 0}
+
+	got := b.BatchDistance(query)
+	want := []float64{0, 5, math.Sqrt(2)}
+	for i := range want {
+		if !vector.NearlyEqualValues(got[i], want[i], 1e-9) && got[i] != want[i] {
+			t.Errorf("b.BatchDistance(query)[%d] == %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestBatchAddPanicsOnDimensionMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("BatchAdd with mismatched batch dimensions did not panic")
+		}
+	}()
+	a, _ := vector.BatchFrom(vector.Vector{1, 2})
+	b, _ := vector.BatchFrom(vector.Vector{1, 2, 3})
+	a.BatchAdd(b)
+}
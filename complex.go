@@ -0,0 +1,25 @@
+package vector
+
+// Complex is an alias for CVec, kept for source compatibility with callers
+// written against the complex vector API before Vec became generic
+type Complex = CVec
+
+// Real lifts a real Vector into C^n with a zero imaginary part on every element
+func Real(v Vector) Complex {
+	c := make(Complex, len(v))
+	for i, el := range v {
+		c[i] = complex(el, 0)
+	}
+	return c
+}
+
+// RealPart projects a complex vector back down to R^n, dropping the
+// imaginary part. It is only meaningful when that imaginary part is
+// negligible. RealPart does not check the imaginary parts it drops.
+func RealPart(v Complex) Vector {
+	r := make(Vector, len(v))
+	for i, el := range v {
+		r[i] = real(el)
+	}
+	return r
+}
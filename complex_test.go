@@ -0,0 +1,152 @@
+package vector_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alexcooperse/vector"
+)
+
+var cset = vector.ComplexSet{
+	vector.Complex{},
+	vector.Complex{1 + 1i},
+	vector.Complex{2, 3i},
+	vector.Complex{1 + 1i, 1 - 1i},
+}
+
+func TestAddComplex(t *testing.T) {
+	cases := []struct {
+		s    []vector.Complex
+		want vector.Complex
+	}{
+		{
+			[]vector.Complex{cset[2], vector.Complex{}},
+			cset[2],
+		},
+		{
+			[]vector.Complex{vector.Complex{1 + 1i}, vector.Complex{-1 - 1i}},
+			vector.Complex{0},
+		},
+	}
+
+	for _, c := range cases {
+		got := vector.Add(c.s...)
+		for i := range c.want {
+			if got[i] != c.want[i] {
+				t.Errorf("Add(%v) == %v, want %v", c.s, got, c.want)
+			}
+		}
+	}
+}
+
+func TestScaleComplex(t *testing.T) {
+	cases := []struct {
+		v    vector.Complex
+		n    complex128
+		want vector.Complex
+	}{
+		{cset[1], 2, vector.Complex{2 + 2i}},
+		{cset[1], 0, vector.Complex{0}},
+		{cset[1], 1i, vector.Complex{-1 + 1i}},
+	}
+
+	for _, c := range cases {
+		got := vector.Scale(c.v, c.n)
+		for i := range c.want {
+			if got[i] != c.want[i] {
+				t.Errorf("Scale(%v, %v) == %v, want %v", c.v, c.n, got, c.want)
+			}
+		}
+	}
+}
+
+func TestInnerProductComplex(t *testing.T) {
+	cases := []struct {
+		u, v vector.Complex
+		want complex128
+	}{
+		// <(1+i), (1+i)> = (1+i)(1-i) = 2, always real for a vector with itself
+		{cset[1], cset[1], 2},
+		// <i, 1> = i * conj(1) = i
+		{vector.Complex{1i}, vector.Complex{1}, 1i},
+		// mismatched lengths: treat the missing elements of the shorter
+		// vector as zero, without swapping the operands (the Hermitian
+		// inner product does not commute, so u and v are not interchangeable)
+		{vector.Complex{1i, 2i, 3i}, vector.Complex{1}, 1i},
+	}
+
+	for _, c := range cases {
+		got := vector.InnerProduct(c.u, c.v)
+		if got != c.want {
+			t.Errorf("InnerProduct(%v, %v) == %v, want %v", c.u, c.v, got, c.want)
+		}
+	}
+}
+
+func TestComplexMag(t *testing.T) {
+	cases := []struct {
+		v    vector.Complex
+		want float64
+	}{
+		{cset[0], 0},
+		{cset[1], math.Sqrt2},
+		{vector.Complex{3, 4i}, 5},
+	}
+
+	for _, c := range cases {
+		got := c.v.Mag()
+		if !vector.NearlyEqualValues(got, c.want, 1e-12) && got != c.want {
+			t.Errorf("%v.Mag() == %v, want %v", c.v, got, c.want)
+		}
+	}
+}
+
+func TestComplexUnit(t *testing.T) {
+	v := vector.Complex{3, 4i}
+	got := v.Unit()
+	if got.Mag() != 1 {
+		t.Errorf("%v.Unit().Mag() == %v, want 1", v, got.Mag())
+	}
+
+	zero := vector.Complex{0, 0}
+	if zero.Unit() != nil {
+		t.Errorf("%v.Unit() == %v, want nil", zero, zero.Unit())
+	}
+}
+
+func TestConjugateTranspose(t *testing.T) {
+	v := vector.Complex{1 + 2i, -3i}
+	want := vector.Complex{1 - 2i, 3i}
+	got := v.ConjugateTranspose()
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("%v.ConjugateTranspose() == %v, want %v", v, got, want)
+		}
+	}
+}
+
+func TestIsOrthogonal(t *testing.T) {
+	cases := []struct {
+		u, v vector.Complex
+		want bool
+	}{
+		{vector.Complex{1, 0}, vector.Complex{0, 1}, true},
+		{vector.Complex{1, 1}, vector.Complex{1, 1}, false},
+	}
+
+	for _, c := range cases {
+		got := vector.IsOrthogonal(c.u, c.v, math.SmallestNonzeroFloat64)
+		if got != c.want {
+			t.Errorf("IsOrthogonal(%v, %v) == %v, want %v", c.u, c.v, got, c.want)
+		}
+	}
+}
+
+func TestRealRoundTrip(t *testing.T) {
+	v := vector.Vector{1, 2, 3}
+	c := vector.Real(v)
+	got := vector.RealPart(c)
+	if !vector.DeeplyEqual(got, v) {
+		t.Errorf("RealPart(Real(%v)) == %v, want %v", v, got, v)
+	}
+}
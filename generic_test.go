@@ -0,0 +1,38 @@
+package vector_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alexcooperse/vector"
+)
+
+func TestVec32(t *testing.T) {
+	u := vector.Vec32{1, 2, 3}
+	v := vector.Vec32{4, 5, 6}
+
+	got := vector.Add(u, v)
+	want := vector.Vec32{5, 7, 9}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Add(%v, %v) == %v, want %v", u, v, got, want)
+		}
+	}
+
+	if mag := u.Mag(); !vector.NearlyEqualValues(mag, math.Sqrt(14), 1e-6) {
+		t.Errorf("%v.Mag() == %v, want %v", u, mag, math.Sqrt(14))
+	}
+}
+
+func TestCVecIsVec(t *testing.T) {
+	var u vector.CVec = vector.Complex{1, 1i}
+	var v vector.Vector = vector.Vector{1, 2}
+	_ = u
+	_ = v
+
+	got := vector.InnerProduct(u, u)
+	want := complex128(2)
+	if got != want {
+		t.Errorf("InnerProduct(%v, %v) == %v, want %v", u, u, got, want)
+	}
+}
@@ -0,0 +1,94 @@
+package vector
+
+import "fmt"
+
+// AddTo adds u and v element-wise into dst and returns dst. dst must have
+// length equal to the longer of u and v (as with Add, the shorter operand is
+// treated as zero-padded); it is safe for dst to alias u or v. AddTo panics
+// if dst's length does not match.
+func AddTo[T Numeric](dst, u, v Vec[T]) Vec[T] {
+	n := len(u)
+	if len(v) > n {
+		n = len(v)
+	}
+	if len(dst) != n {
+		panic(fmt.Sprintf("vector: AddTo: destination has length %d, want %d", len(dst), n))
+	}
+	for i := 0; i < n; i++ {
+		var a, b T
+		if i < len(u) {
+			a = u[i]
+		}
+		if i < len(v) {
+			b = v[i]
+		}
+		dst[i] = a + b
+	}
+	return dst
+}
+
+// SubTo subtracts v from u element-wise into dst and returns dst. dst must
+// have length equal to the longer of u and v; it is safe for dst to alias u
+// or v. SubTo panics if dst's length does not match.
+func SubTo[T Numeric](dst, u, v Vec[T]) Vec[T] {
+	n := len(u)
+	if len(v) > n {
+		n = len(v)
+	}
+	if len(dst) != n {
+		panic(fmt.Sprintf("vector: SubTo: destination has length %d, want %d", len(dst), n))
+	}
+	for i := 0; i < n; i++ {
+		var a, b T
+		if i < len(u) {
+			a = u[i]
+		}
+		if i < len(v) {
+			b = v[i]
+		}
+		dst[i] = a - b
+	}
+	return dst
+}
+
+// ScaleTo scales v by n into dst and returns dst. dst must have the same
+// length as v; it is safe for dst to alias v. ScaleTo panics if dst's length
+// does not match.
+func ScaleTo[T Numeric](dst Vec[T], v Vec[T], n T) Vec[T] {
+	if len(dst) != len(v) {
+		panic(fmt.Sprintf("vector: ScaleTo: destination has length %d, want %d", len(dst), len(v)))
+	}
+	for i, el := range v {
+		dst[i] = el * n
+	}
+	return dst
+}
+
+// CrossTo computes the cross product of u and v into dst and returns dst.
+// As with Cross, u and v must be in three dimensions; dst must also have
+// length 3. It is safe for dst to alias u or v.
+func CrossTo[T Numeric](dst, u, v Vec[T]) (Vec[T], error) {
+	if len(u) != 3 || len(v) != 3 {
+		return nil, &DimensionError{fmt.Errorf("CrossTo(%v, %v, %v): vectors must be in 3 dimensions", dst, u, v)}
+	}
+	if len(dst) != 3 {
+		panic(fmt.Sprintf("vector: CrossTo: destination has length %d, want 3", len(dst)))
+	}
+	// compute into locals first so dst is safe to alias u or v
+	c0 := u[1]*v[2] - u[2]*v[1]
+	c1 := u[2]*v[0] - u[0]*v[2]
+	c2 := u[0]*v[1] - u[1]*v[0]
+	dst[0], dst[1], dst[2] = c0, c1, c2
+	return dst, nil
+}
+
+// AddAssign adds u into v element-wise in place. v must have length equal to
+// the longer of v and u.
+func (v Vec[T]) AddAssign(u Vec[T]) Vec[T] {
+	return AddTo(v, v, u)
+}
+
+// ScaleAssign scales v by n in place.
+func (v Vec[T]) ScaleAssign(n T) Vec[T] {
+	return ScaleTo(v, v, n)
+}
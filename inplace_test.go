@@ -0,0 +1,143 @@
+package vector_test
+
+import (
+	"testing"
+
+	"github.com/alexcooperse/vector"
+)
+
+func TestAddTo(t *testing.T) {
+	cases := []struct {
+		dst, u, v, want vector.Vector
+	}{
+		{make(vector.Vector, 3), vector.Vector{1, 2, 3}, vector.Vector{4, 5, 6}, vector.Vector{5, 7, 9}},
+		{make(vector.Vector, 2), vector.Vector{1, 2}, vector.Vector{-1, -2}, vector.Vector{0, 0}},
+	}
+
+	for _, c := range cases {
+		got := vector.AddTo(c.dst, c.u, c.v)
+		if !vector.DeeplyEqual(got, c.want) {
+			t.Errorf("AddTo(dst, %v, %v) == %v, want %v", c.u, c.v, got, c.want)
+		}
+	}
+}
+
+func TestAddToAliasesDst(t *testing.T) {
+	u := vector.Vector{1, 2, 3}
+	v := vector.Vector{4, 5, 6}
+	got := vector.AddTo(u, u, v)
+	want := vector.Vector{5, 7, 9}
+	if !vector.DeeplyEqual(got, want) {
+		t.Errorf("AddTo(u, u, v) == %v, want %v", got, want)
+	}
+}
+
+func TestAddToPanicsOnLengthMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("AddTo(dst, u, v) with mismatched dst length did not panic")
+		}
+	}()
+	vector.AddTo(make(vector.Vector, 1), vector.Vector{1, 2}, vector.Vector{3, 4})
+}
+
+func TestSubTo(t *testing.T) {
+	dst := make(vector.Vector, 3)
+	got := vector.SubTo(dst, vector.Vector{4, 5, 6}, vector.Vector{1, 2, 3})
+	want := vector.Vector{3, 3, 3}
+	if !vector.DeeplyEqual(got, want) {
+		t.Errorf("SubTo(dst, u, v) == %v, want %v", got, want)
+	}
+}
+
+func TestScaleTo(t *testing.T) {
+	dst := make(vector.Vector, 3)
+	got := vector.ScaleTo(dst, vector.Vector{1, 2, 3}, 2)
+	want := vector.Vector{2, 4, 6}
+	if !vector.DeeplyEqual(got, want) {
+		t.Errorf("ScaleTo(dst, v, 2) == %v, want %v", got, want)
+	}
+}
+
+func TestScaleToPanicsOnLengthMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("ScaleTo(dst, v, n) with mismatched dst length did not panic")
+		}
+	}()
+	vector.ScaleTo(make(vector.Vector, 2), vector.Vector{1, 2, 3}, 2)
+}
+
+func TestCrossTo(t *testing.T) {
+	cases := []struct {
+		dst, u, v, want vector.Vector
+		err             interface{}
+	}{
+		{make(vector.Vector, 3), vector.Vector{1, 0, 0}, vector.Vector{0, 1, 0}, vector.Vector{0, 0, 1}, nil},
+		{make(vector.Vector, 3), vector.Vector{1, 0}, vector.Vector{0, 0, 1}, nil, &vector.DimensionError{}},
+	}
+
+	for _, c := range cases {
+		got, err := vector.CrossTo(c.dst, c.u, c.v)
+		if !vector.DeeplyEqual(got, c.want) {
+			t.Errorf("CrossTo(dst, %v, %v) == %v, want %v", c.u, c.v, got, c.want)
+		}
+		if typeof(err) != typeof(c.err) {
+			t.Errorf("CrossTo(dst, %v, %v): typeof(err) == %v, want %v", c.u, c.v, typeof(err), typeof(c.err))
+		}
+	}
+}
+
+func TestCrossToAliasesDst(t *testing.T) {
+	u := vector.Vector{1, 0, 0}
+	v := vector.Vector{0, 1, 0}
+	got, err := vector.CrossTo(u, u, v)
+	if err != nil {
+		t.Fatalf("CrossTo(u, u, v) returned unexpected error: %v", err)
+	}
+	want := vector.Vector{0, 0, 1}
+	if !vector.DeeplyEqual(got, want) {
+		t.Errorf("CrossTo(u, u, v) == %v, want %v", got, want)
+	}
+}
+
+func TestAddAssign(t *testing.T) {
+	v := vector.Vector{1, 2, 3}
+	v.AddAssign(vector.Vector{1, 1, 1})
+	want := vector.Vector{2, 3, 4}
+	if !vector.DeeplyEqual(v, want) {
+		t.Errorf("v.AddAssign(...) -> %v, want %v", v, want)
+	}
+}
+
+func TestScaleAssign(t *testing.T) {
+	v := vector.Vector{1, 2, 3}
+	v.ScaleAssign(3)
+	want := vector.Vector{3, 6, 9}
+	if !vector.DeeplyEqual(v, want) {
+		t.Errorf("v.ScaleAssign(3) -> %v, want %v", v, want)
+	}
+}
+
+func BenchmarkAddTo(b *testing.B) {
+	u := vector.Vector{1, 2, 3, 4, 5, 6, 7, 8}
+	v := vector.Vector{8, 7, 6, 5, 4, 3, 2, 1}
+	dst := make(vector.Vector, len(u))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		vector.AddTo(dst, u, v)
+	}
+}
+
+func BenchmarkScaleTo(b *testing.B) {
+	v := vector.Vector{1, 2, 3, 4, 5, 6, 7, 8}
+	dst := make(vector.Vector, len(v))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		vector.ScaleTo(dst, v, 2)
+	}
+}
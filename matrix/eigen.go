@@ -0,0 +1,114 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/alexcooperse/vector"
+)
+
+// eigenEps bounds both the symmetry check on the input and the
+// off-diagonal-norm convergence test for Eigen's QR iteration.
+const eigenEps = 1e-10
+
+// eigenMaxIter caps the QR iteration in case a pathological input converges
+// too slowly to reach eigenEps.
+const eigenMaxIter = 1000
+
+func isSymmetric(a Matrix) bool {
+	n := len(a)
+	for i := 0; i < n; i++ {
+		if len(a[i]) != n {
+			return false
+		}
+		for j := i + 1; j < n; j++ {
+			if math.Abs(a[i][j]-a[j][i]) > eigenEps {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func offDiagonalNorm(a Matrix) float64 {
+	var sum float64
+	for i := range a {
+		for j := range a[i] {
+			if i != j {
+				sum += a[i][j] * a[i][j]
+			}
+		}
+	}
+	return math.Sqrt(sum)
+}
+
+// wilkinsonShift picks the eigenvalue of a's trailing 2x2 submatrix closest
+// to a[n-1][n-1], which is what makes the shifted QR iteration converge in
+// roughly cubic rather than linear time.
+func wilkinsonShift(a Matrix) float64 {
+	n := len(a)
+	if n == 1 {
+		return a[0][0]
+	}
+	x := a[n-2][n-2]
+	y := a[n-1][n-1]
+	z := a[n-2][n-1]
+
+	delta := (x - y) / 2
+	sign := 1.0
+	if delta < 0 {
+		sign = -1
+	}
+	denom := math.Abs(delta) + math.Sqrt(delta*delta+z*z)
+	if denom == 0 {
+		return y
+	}
+	return y - sign*z*z/denom
+}
+
+// Eigen computes the eigenvalues and eigenvectors of a symmetric matrix
+// using the QR algorithm with a Wilkinson shift applied at each iteration:
+// it repeatedly factors the shifted matrix a_k - s*I = q*r, forms
+// a_(k+1) = r*q + s*I, and accumulates the eigenvector matrix v = v*q. Once
+// the off-diagonal entries of a_k are within eigenEps of zero, its diagonal
+// holds the eigenvalues and the columns of v hold the corresponding
+// eigenvectors.
+func Eigen(a Matrix) (vector.Vector, Matrix, error) {
+	n, cols := a.Dims()
+	if n != cols {
+		return nil, nil, vector.NewDimensionError("Eigen: matrix must be square, got %dx%d", n, cols)
+	}
+	if !isSymmetric(a) {
+		return nil, nil, vector.NewDimensionError("Eigen: only symmetric matrices are supported")
+	}
+
+	ak := a.copy()
+	v := Identity(n)
+	for iter := 0; iter < eigenMaxIter && offDiagonalNorm(ak) > eigenEps; iter++ {
+		shift := wilkinsonShift(ak)
+		shifted := ak.copy()
+		for i := 0; i < n; i++ {
+			shifted[i][i] -= shift
+		}
+
+		q, r := householderQR(shifted)
+		next, err := Mul(r, q)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := 0; i < n; i++ {
+			next[i][i] += shift
+		}
+		ak = next
+
+		v, err = Mul(v, q)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	eigenvalues := make(vector.Vector, n)
+	for i := 0; i < n; i++ {
+		eigenvalues[i] = ak[i][i]
+	}
+	return eigenvalues, v, nil
+}
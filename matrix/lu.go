@@ -0,0 +1,132 @@
+package matrix
+
+import (
+	"github.com/alexcooperse/vector"
+)
+
+// luDecompose computes an in-place LU decomposition of a with partial
+// pivoting: lu packs L (unit lower triangular, diagonal implied) and U
+// (upper triangular) into a single matrix, piv records the row permutation
+// applied, and sign is the determinant's sign flip from that permutation
+// (+1 for an even number of row swaps, -1 for odd).
+func luDecompose(a Matrix) (lu Matrix, piv []int, sign float64, err error) {
+	n, cols := a.Dims()
+	if n != cols {
+		return nil, nil, 0, vector.NewDimensionError("matrix must be square, got %dx%d", n, cols)
+	}
+	lu = a.copy()
+	piv = make([]int, n)
+	for i := range piv {
+		piv[i] = i
+	}
+	sign = 1
+	for k := 0; k < n; k++ {
+		p := k
+		maxVal := abs(lu[k][k])
+		for i := k + 1; i < n; i++ {
+			if v := abs(lu[i][k]); v > maxVal {
+				maxVal = v
+				p = i
+			}
+		}
+		if p != k {
+			lu[k], lu[p] = lu[p], lu[k]
+			piv[k], piv[p] = piv[p], piv[k]
+			sign = -sign
+		}
+		if lu[k][k] == 0 {
+			// singular: the remaining entries in this column are already
+			// zero, so there is nothing to eliminate
+			continue
+		}
+		for i := k + 1; i < n; i++ {
+			factor := lu[i][k] / lu[k][k]
+			lu[i][k] = factor
+			for j := k + 1; j < n; j++ {
+				lu[i][j] -= factor * lu[k][j]
+			}
+		}
+	}
+	return lu, piv, sign, nil
+}
+
+func abs(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Determinant computes the determinant of a via its LU decomposition
+func Determinant(a Matrix) (float64, error) {
+	lu, _, sign, err := luDecompose(a)
+	if err != nil {
+		return 0, err
+	}
+	det := sign
+	for i := range lu {
+		det *= lu[i][i]
+	}
+	return det, nil
+}
+
+// SolveLU solves a*x = b for x via LU decomposition with partial pivoting
+func SolveLU(a Matrix, b vector.Vector) (vector.Vector, error) {
+	n, cols := a.Dims()
+	if len(b) != cols {
+		return nil, vector.NewDimensionError("SolveLU: %dx%d matrix cannot solve for vector of length %d", n, cols, len(b))
+	}
+	lu, piv, _, err := luDecompose(a)
+	if err != nil {
+		return nil, err
+	}
+	for i := range lu {
+		if lu[i][i] == 0 {
+			return nil, vector.NewDimensionError("SolveLU: matrix is singular")
+		}
+	}
+
+	// forward substitution: solve L*y = P*b (L has an implicit unit diagonal)
+	y := make(vector.Vector, n)
+	for i := range y {
+		y[i] = b[piv[i]]
+	}
+	for i := 0; i < n; i++ {
+		sum := y[i]
+		for j := 0; j < i; j++ {
+			sum -= lu[i][j] * y[j]
+		}
+		y[i] = sum
+	}
+
+	// back substitution: solve U*x = y
+	x := make(vector.Vector, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := y[i]
+		for j := i + 1; j < n; j++ {
+			sum -= lu[i][j] * x[j]
+		}
+		x[i] = sum / lu[i][i]
+	}
+	return x, nil
+}
+
+// Inverse computes the inverse of a by solving a*x = e_j for each standard
+// basis vector e_j and assembling the results into columns
+func Inverse(a Matrix) (Matrix, error) {
+	n, cols := a.Dims()
+	if n != cols {
+		return nil, vector.NewDimensionError("Inverse: matrix must be square, got %dx%d", n, cols)
+	}
+	columns := make(Matrix, n)
+	for j := 0; j < n; j++ {
+		e := make(vector.Vector, n)
+		e[j] = 1
+		x, err := SolveLU(a, e)
+		if err != nil {
+			return nil, err
+		}
+		columns[j] = x
+	}
+	return columns.Transpose(), nil
+}
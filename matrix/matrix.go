@@ -0,0 +1,90 @@
+// Package matrix provides small-scale linear algebra built on top of
+// vector.Vector: matrix-vector and matrix-matrix products, LU and
+// Householder QR solves, and the symmetric eigenvalue problem.
+package matrix
+
+import (
+	"github.com/alexcooperse/vector"
+)
+
+// Matrix is an m×n matrix represented as m row vectors
+type Matrix []vector.Vector
+
+// Dims returns the number of rows and columns in m. cols is the length of
+// the first row, or 0 for an empty matrix; rows are not required to be of
+// uniform length, but every operation in this package requires them to be.
+func (m Matrix) Dims() (rows, cols int) {
+	rows = len(m)
+	if rows > 0 {
+		cols = len(m[0])
+	}
+	return rows, cols
+}
+
+// Identity returns the n×n identity matrix
+func Identity(n int) Matrix {
+	m := make(Matrix, n)
+	for i := range m {
+		row := make(vector.Vector, n)
+		row[i] = 1
+		m[i] = row
+	}
+	return m
+}
+
+// copy returns a deep copy of m
+func (m Matrix) copy() Matrix {
+	c := make(Matrix, len(m))
+	for i, row := range m {
+		r := make(vector.Vector, len(row))
+		copy(r, row)
+		c[i] = r
+	}
+	return c
+}
+
+// Transpose returns the transpose of m
+func (m Matrix) Transpose() Matrix {
+	rows, cols := m.Dims()
+	t := make(Matrix, cols)
+	for j := 0; j < cols; j++ {
+		col := make(vector.Vector, rows)
+		for i := 0; i < rows; i++ {
+			col[i] = m[i][j]
+		}
+		t[j] = col
+	}
+	return t
+}
+
+// Mul multiplies two matrices: Mul(a, b) = a*b
+func Mul(a, b Matrix) (Matrix, error) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ac != br {
+		return nil, vector.NewDimensionError("Mul: %dx%d matrix cannot multiply %dx%d matrix", ar, ac, br, bc)
+	}
+	bt := b.Transpose()
+	product := make(Matrix, ar)
+	for i := 0; i < ar; i++ {
+		row := make(vector.Vector, bc)
+		for j := 0; j < bc; j++ {
+			row[j] = vector.InnerProduct(a[i], bt[j])
+		}
+		product[i] = row
+	}
+	return product, nil
+}
+
+// MatVec multiplies the matrix a by the vector v: a*v
+func MatVec(a Matrix, v vector.Vector) (vector.Vector, error) {
+	_, ac := a.Dims()
+	if ac != len(v) {
+		return nil, vector.NewDimensionError("MatVec: %dx%d matrix cannot multiply vector of length %d", len(a), ac, len(v))
+	}
+	product := make(vector.Vector, len(a))
+	for i, row := range a {
+		product[i] = vector.InnerProduct(row, v)
+	}
+	return product, nil
+}
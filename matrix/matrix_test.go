@@ -0,0 +1,236 @@
+package matrix_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alexcooperse/vector"
+	"github.com/alexcooperse/vector/matrix"
+)
+
+func typeof(v interface{}) string {
+	return fmt.Sprintf("%T", v)
+}
+
+func nearlyEqualMatrix(a, b matrix.Matrix, eps float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !vector.NearlyEqual(a[i], b[i], eps) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestTranspose(t *testing.T) {
+	a := matrix.Matrix{
+		vector.Vector{1, 2, 3},
+		vector.Vector{4, 5, 6},
+	}
+	want := matrix.Matrix{
+		vector.Vector{1, 4},
+		vector.Vector{2, 5},
+		vector.Vector{3, 6},
+	}
+
+	got := a.Transpose()
+	if !nearlyEqualMatrix(got, want, 1e-12) {
+		t.Errorf("Transpose(%v) == %v, want %v", a, got, want)
+	}
+}
+
+func TestMul(t *testing.T) {
+	a := matrix.Matrix{
+		vector.Vector{1, 2},
+		vector.Vector{3, 4},
+	}
+	b := matrix.Matrix{
+		vector.Vector{5, 6},
+		vector.Vector{7, 8},
+	}
+	want := matrix.Matrix{
+		vector.Vector{19, 22},
+		vector.Vector{43, 50},
+	}
+
+	got, err := matrix.Mul(a, b)
+	if err != nil {
+		t.Fatalf("Mul(%v, %v) returned unexpected error: %v", a, b, err)
+	}
+	if !nearlyEqualMatrix(got, want, 1e-12) {
+		t.Errorf("Mul(%v, %v) == %v, want %v", a, b, got, want)
+	}
+
+	_, err = matrix.Mul(a, matrix.Matrix{vector.Vector{1, 2, 3}})
+	if typeof(err) != typeof(&vector.DimensionError{}) {
+		t.Errorf("Mul with mismatched dimensions: typeof(err) == %v, want %v", typeof(err), typeof(&vector.DimensionError{}))
+	}
+}
+
+func TestMatVec(t *testing.T) {
+	a := matrix.Matrix{
+		vector.Vector{1, 2},
+		vector.Vector{3, 4},
+	}
+	v := vector.Vector{5, 6}
+	want := vector.Vector{17, 39}
+
+	got, err := matrix.MatVec(a, v)
+	if err != nil {
+		t.Fatalf("MatVec(%v, %v) returned unexpected error: %v", a, v, err)
+	}
+	if !vector.NearlyEqual(got, want, 1e-12) {
+		t.Errorf("MatVec(%v, %v) == %v, want %v", a, v, got, want)
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	cases := []struct {
+		a    matrix.Matrix
+		want float64
+	}{
+		{
+			matrix.Matrix{
+				vector.Vector{1, 2},
+				vector.Vector{3, 4},
+			},
+			-2,
+		},
+		{
+			matrix.Matrix{
+				vector.Vector{2, 0, 0},
+				vector.Vector{0, 3, 0},
+				vector.Vector{0, 0, 4},
+			},
+			24,
+		},
+		{
+			matrix.Matrix{
+				vector.Vector{1, 2},
+				vector.Vector{2, 4},
+			},
+			0,
+		},
+	}
+
+	for _, c := range cases {
+		got, err := matrix.Determinant(c.a)
+		if err != nil {
+			t.Fatalf("Determinant(%v) returned unexpected error: %v", c.a, err)
+		}
+		if !vector.NearlyEqualValues(got, c.want, 1e-9) && got != c.want {
+			t.Errorf("Determinant(%v) == %v, want %v", c.a, got, c.want)
+		}
+	}
+}
+
+func TestSolveLU(t *testing.T) {
+	a := matrix.Matrix{
+		vector.Vector{2, 1},
+		vector.Vector{1, 3},
+	}
+	b := vector.Vector{3, 5}
+	want := vector.Vector{0.8, 1.4}
+
+	got, err := matrix.SolveLU(a, b)
+	if err != nil {
+		t.Fatalf("SolveLU(%v, %v) returned unexpected error: %v", a, b, err)
+	}
+	if !vector.NearlyEqual(got, want, 1e-9) {
+		t.Errorf("SolveLU(%v, %v) == %v, want %v", a, b, got, want)
+	}
+}
+
+func TestInverse(t *testing.T) {
+	a := matrix.Matrix{
+		vector.Vector{4, 7},
+		vector.Vector{2, 6},
+	}
+	want := matrix.Matrix{
+		vector.Vector{0.6, -0.7},
+		vector.Vector{-0.2, 0.4},
+	}
+
+	got, err := matrix.Inverse(a)
+	if err != nil {
+		t.Fatalf("Inverse(%v) returned unexpected error: %v", a, err)
+	}
+	if !nearlyEqualMatrix(got, want, 1e-9) {
+		t.Errorf("Inverse(%v) == %v, want %v", a, got, want)
+	}
+
+	product, err := matrix.Mul(a, got)
+	if err != nil {
+		t.Fatalf("Mul(a, Inverse(a)) returned unexpected error: %v", err)
+	}
+	if !nearlyEqualMatrix(product, matrix.Identity(2), 1e-9) {
+		t.Errorf("a * Inverse(a) == %v, want the identity", product)
+	}
+}
+
+func TestSolveQR(t *testing.T) {
+	a := matrix.Matrix{
+		vector.Vector{2, 1},
+		vector.Vector{1, 3},
+	}
+	b := vector.Vector{3, 5}
+	want := vector.Vector{0.8, 1.4}
+
+	got, err := matrix.SolveQR(a, b)
+	if err != nil {
+		t.Fatalf("SolveQR(%v, %v) returned unexpected error: %v", a, b, err)
+	}
+	if !vector.NearlyEqual(got, want, 1e-9) {
+		t.Errorf("SolveQR(%v, %v) == %v, want %v", a, b, got, want)
+	}
+}
+
+func TestEigenSymmetric(t *testing.T) {
+	a := matrix.Matrix{
+		vector.Vector{2, 1},
+		vector.Vector{1, 2},
+	}
+
+	values, vectors, err := matrix.Eigen(a)
+	if err != nil {
+		t.Fatalf("Eigen(%v) returned unexpected error: %v", a, err)
+	}
+
+	wantValues := vector.Vector{1, 3}
+	sortedValues := make(vector.Vector, len(values))
+	copy(sortedValues, values)
+	if sortedValues[0] > sortedValues[1] {
+		sortedValues[0], sortedValues[1] = sortedValues[1], sortedValues[0]
+	}
+	if !vector.NearlyEqual(sortedValues, wantValues, 1e-6) {
+		t.Errorf("Eigen(%v) values == %v, want %v", a, sortedValues, wantValues)
+	}
+
+	// each column of vectors should satisfy a*x = lambda*x
+	vt := vectors.Transpose()
+	for i, lambda := range values {
+		x := vt[i]
+		ax, err := matrix.MatVec(a, x)
+		if err != nil {
+			t.Fatalf("MatVec(%v, %v) returned unexpected error: %v", a, x, err)
+		}
+		want := vector.Scale(x, lambda)
+		if !vector.NearlyEqual(ax, want, 1e-6) {
+			t.Errorf("a*v[%d] == %v, want %v (lambda=%v)", i, ax, want, lambda)
+		}
+	}
+}
+
+func TestEigenRejectsAsymmetric(t *testing.T) {
+	a := matrix.Matrix{
+		vector.Vector{1, 2},
+		vector.Vector{3, 4},
+	}
+
+	_, _, err := matrix.Eigen(a)
+	if typeof(err) != typeof(&vector.DimensionError{}) {
+		t.Errorf("Eigen(%v): typeof(err) == %v, want %v", a, typeof(err), typeof(&vector.DimensionError{}))
+	}
+}
@@ -0,0 +1,110 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/alexcooperse/vector"
+)
+
+// householderQR computes the QR factorization of a (rows >= cols) using
+// Householder reflections: q is orthogonal, r is upper triangular, and
+// a = q*r.
+func householderQR(a Matrix) (q, r Matrix) {
+	rows, cols := a.Dims()
+	r = a.copy()
+	q = Identity(rows)
+
+	lim := cols
+	if rows-1 < lim {
+		lim = rows - 1
+	}
+	for k := 0; k < lim; k++ {
+		var normSq float64
+		for i := k; i < rows; i++ {
+			normSq += r[i][k] * r[i][k]
+		}
+		alpha := math.Sqrt(normSq)
+		if r[k][k] > 0 {
+			alpha = -alpha
+		}
+		if alpha == 0 {
+			continue
+		}
+
+		v := make([]float64, rows-k)
+		for i := k; i < rows; i++ {
+			v[i-k] = r[i][k]
+		}
+		v[0] -= alpha
+		var vNormSq float64
+		for _, x := range v {
+			vNormSq += x * x
+		}
+		if vNormSq == 0 {
+			continue
+		}
+
+		// apply the Householder reflection H = I - 2vv^T/(v.v) to r on the
+		// left, restricted to rows k..rows-1
+		for j := k; j < cols; j++ {
+			var dot float64
+			for i := k; i < rows; i++ {
+				dot += v[i-k] * r[i][j]
+			}
+			coeff := 2 * dot / vNormSq
+			for i := k; i < rows; i++ {
+				r[i][j] -= coeff * v[i-k]
+			}
+		}
+		// the reflection zeroes this column below the diagonal exactly in
+		// theory; pin it to exactly zero to avoid carrying rounding noise
+		for i := k + 1; i < rows; i++ {
+			r[i][k] = 0
+		}
+
+		// accumulate q = q*H so that q*r continues to equal a
+		for i := 0; i < rows; i++ {
+			var dot float64
+			for jj := k; jj < rows; jj++ {
+				dot += q[i][jj] * v[jj-k]
+			}
+			coeff := 2 * dot / vNormSq
+			for jj := k; jj < rows; jj++ {
+				q[i][jj] -= coeff * v[jj-k]
+			}
+		}
+	}
+	return q, r
+}
+
+// SolveQR solves a*x = b for x via Householder QR factorization:
+// a = q*r, so x is found by back-substitution on r*x = q^T*b
+func SolveQR(a Matrix, b vector.Vector) (vector.Vector, error) {
+	rows, cols := a.Dims()
+	if rows != cols {
+		return nil, vector.NewDimensionError("SolveQR: matrix must be square, got %dx%d", rows, cols)
+	}
+	if len(b) != rows {
+		return nil, vector.NewDimensionError("SolveQR: %dx%d matrix cannot solve for vector of length %d", rows, cols, len(b))
+	}
+
+	q, r := householderQR(a)
+	qt := q.Transpose()
+	y := make(vector.Vector, rows)
+	for i, row := range qt {
+		y[i] = vector.InnerProduct(row, b)
+	}
+
+	x := make(vector.Vector, cols)
+	for i := rows - 1; i >= 0; i-- {
+		if r[i][i] == 0 {
+			return nil, vector.NewDimensionError("SolveQR: matrix is singular")
+		}
+		sum := y[i]
+		for j := i + 1; j < cols; j++ {
+			sum -= r[i][j] * x[j]
+		}
+		x[i] = sum / r[i][i]
+	}
+	return x, nil
+}
@@ -0,0 +1,90 @@
+package vector
+
+import (
+	"math"
+	"math/cmplx"
+	"reflect"
+)
+
+// Numeric constrains the element type of a Vec to the real and complex
+// floating point types (or any named type built on one of them).
+type Numeric interface {
+	~float32 | ~float64 | ~complex64 | ~complex128
+}
+
+// absT returns the magnitude of x as a float64, whether T is real or complex.
+// The type switch on any(x) only matches exact concrete types, so it handles
+// the four built-in Numeric types without reflection; a named type (e.g.
+// type Meters float64) falls through to reflect.Kind dispatch so it is still
+// handled correctly, just a little slower.
+func absT[T Numeric](x T) float64 {
+	switch v := any(x).(type) {
+	case float32:
+		return math.Abs(float64(v))
+	case float64:
+		return math.Abs(v)
+	case complex64:
+		return cmplx.Abs(complex128(v))
+	case complex128:
+		return cmplx.Abs(v)
+	}
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return math.Abs(rv.Float())
+	case reflect.Complex64, reflect.Complex128:
+		return cmplx.Abs(rv.Complex())
+	default:
+		return 0
+	}
+}
+
+// conjT returns the complex conjugate of x for complex T, or x unchanged for
+// real T. Folding this into the generic arithmetic is what lets InnerProduct
+// compute a real dot product and a Hermitian inner product from a single
+// implementation. As in absT, the any(x) switch covers the four built-in
+// types without reflection, falling back to reflect.Kind for named types.
+func conjT[T Numeric](x T) T {
+	switch v := any(x).(type) {
+	case complex64:
+		return any(complex64(cmplx.Conj(complex128(v)))).(T)
+	case complex128:
+		return any(cmplx.Conj(v)).(T)
+	case float32, float64:
+		return x
+	}
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Complex64, reflect.Complex128:
+		conj := reflect.ValueOf(cmplx.Conj(rv.Complex())).Convert(rv.Type())
+		return conj.Interface().(T)
+	default:
+		return x
+	}
+}
+
+// fromFloat converts a real scalar into T, placing it on the real axis for
+// complex T. As in absT, the any(zero) switch covers the four built-in types
+// without reflection, falling back to reflect.Kind for named types.
+func fromFloat[T Numeric](f float64) T {
+	var zero T
+	switch any(zero).(type) {
+	case float32:
+		return any(float32(f)).(T)
+	case float64:
+		return any(f).(T)
+	case complex64:
+		return any(complex64(complex(f, 0))).(T)
+	case complex128:
+		return any(complex(f, 0)).(T)
+	}
+	rt := reflect.TypeOf(zero)
+	switch rt.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return reflect.ValueOf(f).Convert(rt).Interface().(T)
+	case reflect.Complex64, reflect.Complex128:
+		return reflect.ValueOf(complex(f, 0)).Convert(rt).Interface().(T)
+	default:
+		return zero
+	}
+}
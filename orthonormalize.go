@@ -0,0 +1,30 @@
+package vector
+
+// Orthonormalize returns an orthonormal basis for the span of set, computed
+// via modified Gram-Schmidt: for each vector, the projection onto each
+// previously computed basis vector is subtracted in turn, updating the
+// vector between subtractions (rather than projecting the original vector
+// against all of them at once), before it is normalized. This ordering is
+// what makes modified Gram-Schmidt numerically stable where the classical
+// formulation is not. Vectors that turn out to be linearly dependent on the
+// ones before them collapse to (within rounding of) the zero vector and are
+// dropped from the basis: a remainder whose magnitude is at most 1e-10 times
+// that of the original vector counts as zero.
+func (set VecSet[T]) Orthonormalize() VecSet[T] {
+	basis := make(VecSet[T], 0, len(set))
+	for _, v := range set {
+		w := make(Vec[T], len(v))
+		copy(w, v)
+		for _, q := range basis {
+			proj := InnerProduct(w, q)
+			w = Add(w, Scale(q, -proj))
+		}
+		// rounding can leave a dependent vector with a tiny nonzero
+		// remainder, so compare against v's own magnitude rather than 0
+		if w.Mag() <= 1e-10*v.Mag() {
+			continue
+		}
+		basis = append(basis, w.Unit())
+	}
+	return basis
+}
@@ -0,0 +1,41 @@
+package vector_test
+
+import (
+	"testing"
+
+	"github.com/alexcooperse/vector"
+)
+
+func TestOrthonormalize(t *testing.T) {
+	set := vector.Set{
+		vector.Vector{3, 0},
+		vector.Vector{1, 2},
+	}
+
+	basis := set.Orthonormalize()
+	if len(basis) != 2 {
+		t.Fatalf("Orthonormalize() returned %d vectors, want 2", len(basis))
+	}
+
+	for i, q := range basis {
+		if mag := q.Mag(); !vector.NearlyEqualValues(mag, 1, 1e-12) {
+			t.Errorf("basis[%d].Mag() == %v, want 1", i, mag)
+		}
+	}
+
+	if ip := vector.InnerProduct(basis[0], basis[1]); !vector.NearlyEqualValues(ip+1, 1, 1e-12) && ip != 0 {
+		t.Errorf("InnerProduct(basis[0], basis[1]) == %v, want 0", ip)
+	}
+}
+
+func TestOrthonormalizeDropsDependentVectors(t *testing.T) {
+	set := vector.Set{
+		vector.Vector{1, 0},
+		vector.Vector{2, 0},
+	}
+
+	basis := set.Orthonormalize()
+	if len(basis) != 1 {
+		t.Fatalf("Orthonormalize() returned %d vectors, want 1 (second vector is dependent)", len(basis))
+	}
+}
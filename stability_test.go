@@ -0,0 +1,98 @@
+package vector_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alexcooperse/vector"
+)
+
+// TestMagLargeComponentsNoOverflow builds a long vector containing a
+// component near math.MaxFloat64 and confirms Mag scales before squaring so
+// it doesn't overflow to +Inf the way a naive sum-of-squares would.
+func TestMagLargeComponentsNoOverflow(t *testing.T) {
+	const n = 1000000
+	v := make(vector.Vector, n)
+	v[0] = math.MaxFloat64 / 2
+	for i := 1; i < n; i++ {
+		v[i] = 1
+	}
+
+	got := v.Mag()
+	if math.IsInf(got, 0) || math.IsNaN(got) {
+		t.Fatalf("v.Mag() == %v, want a finite value", got)
+	}
+
+	naive := v.MagNaive()
+	if !math.IsInf(naive, 1) {
+		t.Fatalf("test setup invalid: v.MagNaive() == %v, want +Inf to demonstrate the naive overflow this test guards against", naive)
+	}
+}
+
+// TestMagTinyComponentsNoUnderflow builds a long vector whose components are
+// all math.SmallestNonzeroFloat64 and confirms Mag's scaled two-pass norm
+// keeps them from vanishing to zero when squared, the way a naive
+// sum-of-squares would.
+func TestMagTinyComponentsNoUnderflow(t *testing.T) {
+	const n = 1000000
+	v := make(vector.Vector, n)
+	for i := 0; i < n; i++ {
+		v[i] = math.SmallestNonzeroFloat64
+	}
+
+	// sqrt(n) == 1000 exactly, and 1000 * SmallestNonzeroFloat64 is representable
+	want := math.SmallestNonzeroFloat64 * 1000
+	got := v.Mag()
+	if !vector.NearlyEqualValues(got, want, 1e-9) {
+		t.Errorf("v.Mag() == %v, want ~%v", got, want)
+	}
+}
+
+// TestInnerProductCompensatedSummation sums a long vector holding one large
+// value followed by many small ones against an all-ones vector and confirms the
+// Neumaier-compensated InnerProduct doesn't lose the tiny contributions the
+// way naive summation does.
+func TestInnerProductCompensatedSummation(t *testing.T) {
+	const n = 1000000
+	u := make(vector.Vector, n)
+	v := make(vector.Vector, n)
+	u[0] = 1e16
+	v[0] = 1
+	for i := 1; i < n; i++ {
+		u[i] = 1
+		v[i] = 1
+	}
+
+	want := 1e16 + float64(n-1)
+	got := vector.InnerProduct(u, v)
+	if !vector.NearlyEqualValues(got, want, 1e-12) {
+		t.Errorf("InnerProduct(u, v) == %v, want %v", got, want)
+	}
+
+	naive := vector.DotNaive(u, v)
+	if naive == want {
+		t.Fatalf("test setup invalid: DotNaive(u, v) == %v already matches %v; expected naive summation to lose the small terms", naive, want)
+	}
+}
+
+// TestSumCompensatedSummation mirrors TestInnerProductCompensatedSummation
+// for Set.Sum: a long run of small vectors added against one large vector
+// should not lose the small vectors' contribution to rounding error.
+func TestSumCompensatedSummation(t *testing.T) {
+	const n = 1000000
+	vecs := make(vector.Set, n)
+	vecs[0] = vector.Vector{1e16}
+	for i := 1; i < n; i++ {
+		vecs[i] = vector.Vector{1}
+	}
+
+	got, err := vecs.Sum()
+	if err != nil {
+		t.Fatalf("Sum() returned unexpected error: %v", err)
+	}
+
+	want := 1e16 + float64(n-1)
+	if !vector.NearlyEqualValues(got[0], want, 1e-12) {
+		t.Errorf("Sum()[0] == %v, want %v", got[0], want)
+	}
+}
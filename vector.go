@@ -1,4 +1,5 @@
-// Package vector provides basic vector mathematics for Euclidean n-space
+// Package vector provides basic vector mathematics for Euclidean (and, via
+// complex element types, Hermitian) n-space
 package vector
 
 import (
@@ -7,8 +8,18 @@ import (
 	"sort"
 )
 
-// Vector is an element of a vector space in R^n
-type Vector []float64
+// Vec is an element of a vector space over T: R^n for real T, C^n for complex T
+type Vec[T Numeric] []T
+
+// Vector is a Vec of float64, the default real vector space R^n
+type Vector = Vec[float64]
+
+// Vec32 is a Vec of float32, for callers (e.g. graphics code) that want half
+// the memory footprint of Vector
+type Vec32 = Vec[float32]
+
+// CVec is a Vec of complex128, the complex vector space C^n
+type CVec = Vec[complex128]
 
 // DeeplyEqual tests whether two vectors are element-wise equal within the smallest possible tolerance
 func DeeplyEqual(u Vector, v Vector) bool {
@@ -37,15 +48,15 @@ func Close(u Vector, v Vector, eps float64) bool {
 }
 
 // Add defines vector addition
-func Add(vecs ...Vector) Vector {
-	set := Set(vecs)
+func Add[T Numeric](vecs ...Vec[T]) Vec[T] {
+	set := VecSet[T](vecs)
 	new, _ := set.Sum()
 	return new
 }
 
 // Scale defines scalar multiplication on a vector
-func Scale(v Vector, n float64) (new Vector) {
-	new = make(Vector, len(v))
+func Scale[T Numeric](v Vec[T], n T) (new Vec[T]) {
+	new = make(Vec[T], len(v))
 	for dim, el := range v {
 		new[dim] = el * n
 	}
@@ -53,35 +64,69 @@ func Scale(v Vector, n float64) (new Vector) {
 }
 
 // Distance returns the distance between two vectors
-func Distance(u Vector, v Vector) (d float64) {
-	return Add(u, Scale(v, -1)).Mag()
+func Distance[T Numeric](u Vec[T], v Vec[T]) (d float64) {
+	return Add(u, Scale(v, fromFloat[T](-1))).Mag()
 }
 
-// Dot implements the InnerProduct fot real numbers
-// the InnerProduct may eventually extend to include complex numbers
-func Dot(u Vector, v Vector) (sum float64) {
+// Dot implements the InnerProduct for real T
+// see InnerProduct for the Hermitian inner product used by complex T
+func Dot[T Numeric](u Vec[T], v Vec[T]) (sum T) {
 	return InnerProduct(u, v)
 }
 
-// InnerProduct returns the inner product of two vectors
-func InnerProduct(u Vector, v Vector) (sum float64) {
-	// handle length mismatch by sorting the two vectors
-	set := Set{u, v}
-	sort.Sort(set)
-	// assuming zero values for any 'missing' elements implies
-	// it is sufficient to iterate over the smallest vector
-	for i := range set[0] {
-		sum += set[0][i] * set[1][i]
+// InnerProduct returns the inner product of two vectors: a real dot product
+// for real T, or a Hermitian inner product (sum u[i] * conj(v[i])) for
+// complex T. It is accumulated with Neumaier compensated summation so that
+// long vectors or ones mixing very large and very small magnitudes don't
+// lose precision to naive summation. See DotNaive for the uncompensated
+// fast path.
+func InnerProduct[T Numeric](u Vec[T], v Vec[T]) (sum T) {
+	// handle length mismatch by assuming zero values for any 'missing'
+	// elements in the longer vector, which implies it is sufficient to
+	// iterate up to the length of the shorter one. u and v must stay in
+	// their original order: the Hermitian inner product does not commute,
+	// so swapping them (e.g. by sorting) would silently conjugate the result.
+	n := len(u)
+	if len(v) < n {
+		n = len(v)
+	}
+	var c T
+	for i := 0; i < n; i++ {
+		y := u[i] * conjT(v[i])
+		t := sum + y
+		if absT(sum) >= absT(y) {
+			c += (sum - t) + y
+		} else {
+			c += (y - t) + sum
+		}
+		sum = t
+	}
+	return sum + c
+}
+
+// DotNaive implements InnerProduct using naive, uncompensated summation. It
+// is faster than InnerProduct but loses precision on long vectors or ones
+// mixing very large and very small magnitudes; prefer InnerProduct unless
+// profiling says otherwise.
+func DotNaive[T Numeric](u Vec[T], v Vec[T]) (sum T) {
+	// see InnerProduct: u and v must stay in their original order, since the
+	// Hermitian inner product does not commute.
+	n := len(u)
+	if len(v) < n {
+		n = len(v)
+	}
+	for i := 0; i < n; i++ {
+		sum += u[i] * conjT(v[i])
 	}
 	return sum
 }
 
 // Cross is the cross product of two vectors in three dimensions
-func Cross(u Vector, v Vector) (Vector, error) {
+func Cross[T Numeric](u Vec[T], v Vec[T]) (Vec[T], error) {
 	if len(u) != 3 || len(v) != 3 {
 		return nil, &DimensionError{fmt.Errorf("Cross(%v, %v): vectors must be in 3 dimensions", u, v)}
 	}
-	cross := make(Vector, 3)
+	cross := make(Vec[T], 3)
 	cross[0] = u[1]*v[2] - u[2]*v[1]
 	cross[1] = u[2]*v[0] - u[0]*v[2]
 	cross[2] = u[0]*v[1] - u[1]*v[0]
@@ -89,58 +134,138 @@ func Cross(u Vector, v Vector) (Vector, error) {
 }
 
 // Len is the number of elements in a vector
-func (v Vector) Len() int {
+func (v Vec[T]) Len() int {
 	return len(v)
 }
 
-// Mag calculates a vector's magnitude (2-norm)
-func (v Vector) Mag() float64 {
-	return math.Sqrt(InnerProduct(v, v))
+// Mag calculates a vector's magnitude (2-norm) using the BLAS-style scaled
+// two-pass norm: it finds scale = max|x_i|, then computes
+// scale * sqrt(sum((|x_i|/scale)^2)) so that components near math.MaxFloat64
+// don't overflow when squared and components near
+// math.SmallestNonzeroFloat64 don't vanish to zero. The sum of scaled
+// squares is itself accumulated with Neumaier compensated summation.
+// See MagNaive for the uncompensated, unscaled fast path.
+func (v Vec[T]) Mag() float64 {
+	var scale float64
+	for _, x := range v {
+		if ax := absT(x); ax > scale {
+			scale = ax
+		}
+	}
+	if scale == 0 {
+		return 0
+	}
+
+	var sumSq, c float64
+	for _, x := range v {
+		r := absT(x) / scale
+		y := r * r
+		t := sumSq + y
+		if math.Abs(sumSq) >= math.Abs(y) {
+			c += (sumSq - t) + y
+		} else {
+			c += (y - t) + sumSq
+		}
+		sumSq = t
+	}
+	return scale * math.Sqrt(sumSq+c)
+}
+
+// MagNaive calculates a vector's magnitude as sqrt(sum(|x_i|^2)) with no
+// scaling and no compensated summation. It is faster than Mag but can
+// overflow, underflow, or lose precision on vectors with very large, very
+// small, or very long runs of components; prefer Mag unless profiling says
+// otherwise.
+func (v Vec[T]) MagNaive() float64 {
+	var sumSq float64
+	for _, x := range v {
+		a := absT(x)
+		sumSq += a * a
+	}
+	return math.Sqrt(sumSq)
 }
 
 // Unit returns a vector's unit vector representation
-func (v Vector) Unit() Vector {
+func (v Vec[T]) Unit() Vec[T] {
 	mag := v.Mag()
 	if mag == 0 {
 		return nil
 	}
-	return Scale(v, 1/v.Mag())
+	return Scale(v, fromFloat[T](1/mag))
+}
+
+// ConjugateTranspose returns the element-wise complex conjugate of v. It is
+// the identity for real T; for complex T it is what lets v act as the <·, v>
+// side of the Hermitian inner product, since InnerProduct conjugates its
+// second argument.
+func (v Vec[T]) ConjugateTranspose() Vec[T] {
+	ct := make(Vec[T], len(v))
+	for i, el := range v {
+		ct[i] = conjT(el)
+	}
+	return ct
 }
 
-// Set of vector pointers on which to perform a mathematical operation
+// IsOrthogonal returns true if the inner product of u and v is zero within
+// the tolerance provided
+func IsOrthogonal[T Numeric](u Vec[T], v Vec[T], eps float64) bool {
+	return absT(InnerProduct(u, v)) <= eps
+}
+
+// VecSet of vectors on which to perform a mathematical operation
 // implements sort.Interface by vector length
-type Set []Vector
+type VecSet[T Numeric] []Vec[T]
+
+// Set is a VecSet of float64 vectors, the default real VecSet
+type Set = VecSet[float64]
+
+// ComplexSet is a VecSet of complex128 vectors
+type ComplexSet = VecSet[complex128]
 
-// Len is the length of Set
-func (set Set) Len() int {
+// Len is the length of VecSet
+func (set VecSet[T]) Len() int {
 	return len(set)
 }
 
-// Swap switches the position of two elements in a Set
-func (set Set) Swap(i, j int) {
+// Swap switches the position of two elements in a VecSet
+func (set VecSet[T]) Swap(i, j int) {
 	set[i], set[j] = set[j], set[i]
 }
 
-// Less returns true if the i-th vector has fewer elements than the j-th vector in Set
-func (set Set) Less(i, j int) bool {
+// Less returns true if the i-th vector has fewer elements than the j-th vector in VecSet
+func (set VecSet[T]) Less(i, j int) bool {
 	return len(set[i]) < len(set[j])
 }
 
-// Sum defines vector addition on a Set
+// Sum defines vector addition on a VecSet
 // addition of vectors of differing lengths is possible by assuming zero values for missing elements
-func (set Set) Sum() (sum Vector, err error) {
+// each dimension is accumulated with Neumaier compensated summation so that
+// sets with many vectors, or vectors mixing very large and very small
+// magnitudes, don't lose precision to naive summation
+func (set VecSet[T]) Sum() (sum Vec[T], err error) {
 	// sort vectors from most to least number of elements
 	sort.Sort(sort.Reverse(set))
 	// since the vector of greatest length is the first element of the set,
 	// element-wise addition can be perfomed with subsequent vectors
-	sum = make(Vector, len(set[0]))
+	sum = make(Vec[T], len(set[0]))
 	copy(sum, set[0])
+	c := make(Vec[T], len(set[0]))
 	for i := 1; i < len(set); i++ {
 		v := set[i]
 		for dim, el := range v {
-			sum[dim] += el
+			s := sum[dim]
+			t := s + el
+			if absT(s) >= absT(el) {
+				c[dim] += (s - t) + el
+			} else {
+				c[dim] += (el - t) + s
+			}
+			sum[dim] = t
 		}
 	}
+	for dim := range sum {
+		sum[dim] += c[dim]
+	}
 	return sum, nil
 }
 
@@ -164,3 +289,11 @@ func NearlyEqualValues(x, y, eps float64) bool {
 type DimensionError struct {
 	error
 }
+
+// NewDimensionError constructs a *DimensionError with a formatted message.
+// Packages built on top of vector (e.g. matrix) that need to report their
+// own dimension mismatches use this rather than constructing a
+// DimensionError directly, since its error field is unexported.
+func NewDimensionError(format string, a ...interface{}) *DimensionError {
+	return &DimensionError{fmt.Errorf(format, a...)}
+}
@@ -192,7 +192,7 @@ func TestDistance(t *testing.T) {
 
 	for _, c := range cases {
 		got := vector.Distance(c.u, c.v)
-		if got != c.want {
+		if !vector.NearlyEqualValues(got, c.want, 1e-12) {
 			t.Errorf("Distance(%v, %v) == %v, want %v", c.u, c.v, got, c.want)
 		}
 	}
@@ -303,7 +303,7 @@ func TestMag(t *testing.T) {
 
 	for _, c := range cases {
 		got := c.v.Mag()
-		if got != c.want {
+		if !vector.NearlyEqualValues(got, c.want, 1e-12) && got != c.want {
 			t.Errorf("%v.Mag() == %v, want %v", c.v, got, c.want)
 		}
 	}
@@ -324,7 +324,7 @@ func TestUnit(t *testing.T) {
 
 	for _, c := range cases {
 		got := c.v.Unit()
-		if !vector.DeeplyEqual(got, c.want) {
+		if !vector.NearlyEqual(got, c.want, 1e-12) && !vector.DeeplyEqual(got, c.want) {
 			t.Errorf("%v.Unit() == %v, want %v", c.v, got, c.want)
 		}
 	}